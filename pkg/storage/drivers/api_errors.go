@@ -0,0 +1,194 @@
+/*
+ * Minimalist Object Storage, (C) 2015 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drivers
+
+import "net/http"
+
+// ErrorCode - enum of S3-compatible error codes returned by the drivers package
+type ErrorCode int
+
+// Error code constants - keep in sync with the errorCodeResponse registry below
+const (
+	ErrNone ErrorCode = iota
+	ErrSignatureDoesNotMatch
+	ErrAccessDenied
+	ErrStorageFull
+	ErrSlowDown
+	ErrServiceUnavailable
+	ErrInsufficientReadQuorum
+	ErrInsufficientWriteQuorum
+	ErrEntityTooSmall
+	ErrEntityTooLarge
+	ErrNoSuchUpload
+	ErrInvalidPart
+	ErrMalformedXML
+	ErrPreconditionFailed
+	ErrNotModified
+	ErrObjectTampered
+	ErrMaximumExpires
+	ErrMethodNotAllowed
+	ErrBucketNotFound
+	ErrBucketExists
+	ErrBucketNameInvalid
+	ErrTooManyBuckets
+	ErrObjectNotFound
+	ErrObjectExists
+	ErrObjectNameInvalid
+	ErrBadDigest
+	ErrInvalidDigest
+	ErrNoSuchVersion
+	ErrInvalidObjectState
+	ErrObjectLocked
+	ErrInvalidRetentionPeriod
+	ErrObjectLockConfigurationNotFound
+	ErrNoSuchBucketPolicy
+	ErrNoSuchLifecycleConfiguration
+	ErrReplicationConfigurationNotFound
+	ErrInternalError
+)
+
+// APIError - structured representation of an S3 REST API error
+type APIError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+// errorCodeResponse - registry mapping an ErrorCode to its S3 wire representation.
+// New backends (memory, filesystem, future erasure/distributed) all funnel
+// through this table so clients see the same error code/status regardless of
+// which backend produced the error.
+var errorCodeResponse = map[ErrorCode]APIError{
+	ErrNone:                             {"", "", http.StatusOK},
+	ErrSignatureDoesNotMatch:            {"SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.", http.StatusForbidden},
+	ErrAccessDenied:                     {"AccessDenied", "Access Denied.", http.StatusForbidden},
+	ErrStorageFull:                      {"StorageFull", "Storage backend has reached its minimum free drive threshold.", http.StatusInsufficientStorage},
+	ErrSlowDown:                         {"SlowDown", "Please reduce your request rate.", http.StatusServiceUnavailable},
+	ErrServiceUnavailable:               {"ServiceUnavailable", "The service is unable to handle the request.", http.StatusServiceUnavailable},
+	ErrInsufficientReadQuorum:           {"InsufficientReadQuorum", "Storage resources are insufficient for the read operation.", http.StatusServiceUnavailable},
+	ErrInsufficientWriteQuorum:          {"InsufficientWriteQuorum", "Storage resources are insufficient for the write operation.", http.StatusServiceUnavailable},
+	ErrEntityTooSmall:                   {"EntityTooSmall", "Your proposed upload is smaller than the minimum allowed object size.", http.StatusBadRequest},
+	ErrEntityTooLarge:                   {"EntityTooLarge", "Your proposed upload exceeds the maximum allowed object size.", http.StatusBadRequest},
+	ErrNoSuchUpload:                     {"NoSuchUpload", "The specified multipart upload does not exist.", http.StatusNotFound},
+	ErrInvalidPart:                      {"InvalidPart", "One or more of the specified parts could not be found.", http.StatusBadRequest},
+	ErrMalformedXML:                     {"MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.", http.StatusBadRequest},
+	ErrPreconditionFailed:               {"PreconditionFailed", "At least one of the preconditions you specified did not hold.", http.StatusPreconditionFailed},
+	ErrNotModified:                      {"NotModified", "The object was not modified.", http.StatusNotModified},
+	ErrObjectTampered:                   {"XMinioObjectTampered", "The requested object was found to have been tampered with.", http.StatusPartialContent},
+	ErrMaximumExpires:                   {"MaximumExpires", "Requested expires parameter exceeds the maximum allowed.", http.StatusBadRequest},
+	ErrMethodNotAllowed:                 {"MethodNotAllowed", "The specified method is not allowed against this resource.", http.StatusMethodNotAllowed},
+	ErrBucketNotFound:                   {"NoSuchBucket", "The specified bucket does not exist.", http.StatusNotFound},
+	ErrBucketExists:                     {"BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.", http.StatusConflict},
+	ErrBucketNameInvalid:                {"InvalidBucketName", "The specified bucket is not valid.", http.StatusBadRequest},
+	ErrTooManyBuckets:                   {"TooManyBuckets", "You have attempted to create more buckets than allowed.", http.StatusBadRequest},
+	ErrObjectNotFound:                   {"NoSuchKey", "The specified key does not exist.", http.StatusNotFound},
+	ErrObjectExists:                     {"ObjectAlreadyExists", "The specified object already exists.", http.StatusConflict},
+	ErrObjectNameInvalid:                {"InvalidObjectName", "The specified object name is not valid.", http.StatusBadRequest},
+	ErrBadDigest:                        {"BadDigest", "The Content-Md5 you specified did not match what we received.", http.StatusBadRequest},
+	ErrInvalidDigest:                    {"InvalidDigest", "The Content-Md5 you specified is not valid.", http.StatusBadRequest},
+	ErrNoSuchVersion:                    {"NoSuchVersion", "The specified version does not exist.", http.StatusNotFound},
+	ErrInvalidObjectState:               {"InvalidObjectState", "The operation is not valid for the current state of the object.", http.StatusForbidden},
+	ErrObjectLocked:                     {"ObjectLocked", "The operation is not allowed against a locked object.", http.StatusForbidden},
+	ErrInvalidRetentionPeriod:           {"InvalidRetentionPeriod", "The retention period configured is not valid.", http.StatusBadRequest},
+	ErrObjectLockConfigurationNotFound:  {"ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket.", http.StatusNotFound},
+	ErrNoSuchBucketPolicy:               {"NoSuchBucketPolicy", "The bucket policy does not exist.", http.StatusNotFound},
+	ErrNoSuchLifecycleConfiguration:     {"NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist.", http.StatusNotFound},
+	ErrReplicationConfigurationNotFound: {"ReplicationConfigurationNotFoundError", "The replication configuration does not exist.", http.StatusNotFound},
+	ErrInternalError:                    {"InternalError", "We encountered an internal error, please try again.", http.StatusInternalServerError},
+}
+
+// ToAPIError - maps a drivers error into its S3-compatible APIError
+// representation so the HTTP layer can marshal a proper <Error> XML body
+// instead of hand-rolling status codes. Unwraps a DriverError first, so
+// callers may pass either the original backend error or one wrapped with
+// WrapWithContext.
+func ToAPIError(err error) APIError {
+	var code ErrorCode
+	switch unwrapDriverError(err).(type) {
+	case SignatureDoesNotMatch:
+		code = ErrSignatureDoesNotMatch
+	case AccessDenied:
+		code = ErrAccessDenied
+	case StorageFull:
+		code = ErrStorageFull
+	case SlowDown:
+		code = ErrSlowDown
+	case ServiceUnavailable:
+		code = ErrServiceUnavailable
+	case InsufficientReadQuorum:
+		code = ErrInsufficientReadQuorum
+	case InsufficientWriteQuorum:
+		code = ErrInsufficientWriteQuorum
+	case EntityTooSmall:
+		code = ErrEntityTooSmall
+	case EntityTooLarge:
+		code = ErrEntityTooLarge
+	case NoSuchUpload:
+		code = ErrNoSuchUpload
+	case InvalidPart:
+		code = ErrInvalidPart
+	case MalformedXML:
+		code = ErrMalformedXML
+	case PreconditionFailed:
+		code = ErrPreconditionFailed
+	case NotModified:
+		code = ErrNotModified
+	case ObjectTampered:
+		code = ErrObjectTampered
+	case MaximumExpires:
+		code = ErrMaximumExpires
+	case MethodNotAllowed:
+		code = ErrMethodNotAllowed
+	case BucketNotFound:
+		code = ErrBucketNotFound
+	case BucketExists:
+		code = ErrBucketExists
+	case BucketNameInvalid:
+		code = ErrBucketNameInvalid
+	case TooManyBuckets:
+		code = ErrTooManyBuckets
+	case ObjectNotFound:
+		code = ErrObjectNotFound
+	case ObjectExists:
+		code = ErrObjectExists
+	case ObjectNameInvalid:
+		code = ErrObjectNameInvalid
+	case BadDigest:
+		code = ErrBadDigest
+	case InvalidDigest:
+		code = ErrInvalidDigest
+	case NoSuchVersion:
+		code = ErrNoSuchVersion
+	case InvalidObjectState:
+		code = ErrInvalidObjectState
+	case ObjectLocked:
+		code = ErrObjectLocked
+	case InvalidRetentionPeriod:
+		code = ErrInvalidRetentionPeriod
+	case ObjectLockConfigurationNotFound:
+		code = ErrObjectLockConfigurationNotFound
+	case NoSuchBucketPolicy:
+		code = ErrNoSuchBucketPolicy
+	case NoSuchLifecycleConfiguration:
+		code = ErrNoSuchLifecycleConfiguration
+	case ReplicationConfigurationNotFound:
+		code = ErrReplicationConfigurationNotFound
+	default:
+		code = ErrInternalError
+	}
+	return errorCodeResponse[code]
+}
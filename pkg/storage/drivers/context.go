@@ -0,0 +1,130 @@
+/*
+ * Minimalist Object Storage, (C) 2015 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drivers
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+)
+
+// DriverError - context-aware wrapper around a driver-level error. It
+// carries the operation and bucket/object that produced the error along
+// with the request ID pulled from ctx, so logs and client-visible errors
+// correlate via a single request ID. Supersedes the old ImplementationError.
+type DriverError struct {
+	Op        string
+	Bucket    string
+	Object    string
+	RequestID string
+	Err       error
+}
+
+// Return string an error formatted as the given text
+func (e DriverError) Error() string {
+	error := ""
+	if e.Op != "" {
+		error = error + e.Op + ": "
+	}
+	if e.Bucket != "" {
+		error = error + "Bucket: " + e.Bucket + " "
+	}
+	if e.Object != "" {
+		error = error + "Object: " + e.Object + " "
+	}
+	if e.RequestID != "" {
+		error = error + "RequestID: " + e.RequestID + " "
+	}
+	error = error + "Error: " + e.Err.Error()
+	return error
+}
+
+// Unwrap - allows errors.Is / errors.As to see through to the wrapped error
+func (e DriverError) Unwrap() error {
+	return e.Err
+}
+
+type contextKey int
+
+const requestIDContextKey contextKey = iota
+
+// WithRequestID - returns a copy of ctx carrying requestID, for use by
+// WrapWithContext and ErrorResponse further down the call chain.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+// RequestIDFromContext - extracts the request ID embedded in ctx by
+// WithRequestID, or "" if none was set.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey).(string)
+	return requestID
+}
+
+// WrapWithContext - wraps err with the operation, bucket/object, and the
+// request ID (if any) carried by ctx. Driver call sites should use this
+// instead of returning raw backend errors so the request ID survives to the
+// HTTP layer.
+func WrapWithContext(ctx context.Context, op, bucket, object string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return DriverError{
+		Op:        op,
+		Bucket:    bucket,
+		Object:    object,
+		RequestID: RequestIDFromContext(ctx),
+		Err:       err,
+	}
+}
+
+// APIErrorResponse - S3-compatible <Error> XML error body
+type APIErrorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource"`
+	RequestID string   `xml:"RequestId"`
+	HostID    string   `xml:"HostId"`
+}
+
+// ErrorResponse - builds the S3-compatible <Error> XML body for err. If
+// requestID is empty it falls back to the request ID carried by ctx, so
+// handlers that only have the context still get a correlated response.
+func ErrorResponse(ctx context.Context, err error, resource, requestID, hostID string) APIErrorResponse {
+	if requestID == "" {
+		requestID = RequestIDFromContext(ctx)
+	}
+	apiErr := ToAPIError(err)
+	return APIErrorResponse{
+		Code:      apiErr.Code,
+		Message:   apiErr.Description,
+		Resource:  resource,
+		RequestID: requestID,
+		HostID:    hostID,
+	}
+}
+
+// unwrapDriverError - peels off a DriverError so ToAPIError can switch on
+// the original backend error type underneath.
+func unwrapDriverError(err error) error {
+	var driverErr DriverError
+	if errors.As(err, &driverErr) {
+		return driverErr.Err
+	}
+	return err
+}
@@ -42,13 +42,6 @@ type GenericObjectError struct {
 	Object string
 }
 
-// ImplementationError - generic implementation error
-type ImplementationError struct {
-	Bucket string
-	Object string
-	Err    error
-}
-
 // DigestError - Generic Md5 error
 type DigestError struct {
 	Bucket string
@@ -105,28 +98,6 @@ type BadDigest DigestError
 // InvalidDigest - md5 in request header invalid
 type InvalidDigest DigestError
 
-// Return string an error formatted as the given text
-func (e ImplementationError) Error() string {
-	error := ""
-	if e.Bucket != "" {
-		error = error + "Bucket: " + e.Bucket + " "
-	}
-	if e.Object != "" {
-		error = error + "Object: " + e.Object + " "
-	}
-	error = error + "Error: " + e.Err.Error()
-	return error
-}
-
-// EmbedError - wrapper function for error object
-func EmbedError(bucket, object string, err error) ImplementationError {
-	return ImplementationError{
-		Bucket: bucket,
-		Object: object,
-		Err:    err,
-	}
-}
-
 // Return string an error formatted as the given text
 func (e ObjectNotFound) Error() string {
 	return "Object not Found: " + e.Bucket + "#" + e.Object
@@ -206,3 +177,200 @@ type InvalidRange struct {
 func (e InvalidRange) Error() string {
 	return fmt.Sprintf("Invalid range start:%d length:%d", e.Start, e.Length)
 }
+
+/// S3 compatibility errors
+
+// SignatureDoesNotMatch - request signature does not match
+type SignatureDoesNotMatch struct{}
+
+func (e SignatureDoesNotMatch) Error() string {
+	return "The request signature we calculated does not match the signature you provided"
+}
+
+// AccessDenied - access denied for the requested operation
+type AccessDenied struct {
+	Resource string
+}
+
+func (e AccessDenied) Error() string {
+	return "Access Denied: " + e.Resource
+}
+
+// StorageFull - backend storage has reached its minimum free threshold
+type StorageFull struct{}
+
+func (e StorageFull) Error() string {
+	return "Storage backend has reached its minimum free drive threshold"
+}
+
+// SlowDown - backend is temporarily unable to accept requests
+type SlowDown struct{}
+
+func (e SlowDown) Error() string {
+	return "Please reduce your request rate"
+}
+
+// InsufficientReadQuorum - could not achieve the read quorum required across disks/nodes
+type InsufficientReadQuorum GenericObjectError
+
+func (e InsufficientReadQuorum) Error() string {
+	return "Insufficient read quorum for: " + e.Bucket + "#" + e.Object
+}
+
+// InsufficientWriteQuorum - could not achieve the write quorum required across disks/nodes
+type InsufficientWriteQuorum GenericObjectError
+
+func (e InsufficientWriteQuorum) Error() string {
+	return "Insufficient write quorum for: " + e.Bucket + "#" + e.Object
+}
+
+// EntityTooSmall - object size is under the minimum allowed limit
+type EntityTooSmall struct {
+	GenericObjectError
+	Size      string
+	TotalSize string
+}
+
+func (e EntityTooSmall) Error() string {
+	return e.Bucket + "#" + e.Object + " with " + e.Size + " is smaller than the minimum allowed size " + e.TotalSize
+}
+
+// NoSuchUpload - referenced multipart upload does not exist
+type NoSuchUpload struct {
+	Bucket   string
+	Object   string
+	UploadID string
+}
+
+func (e NoSuchUpload) Error() string {
+	return "No such upload: " + e.Bucket + "#" + e.Object + " uploadId: " + e.UploadID
+}
+
+// InvalidPart - one or more of the specified parts could not be found
+type InvalidPart struct {
+	Bucket     string
+	Object     string
+	UploadID   string
+	PartNumber int
+}
+
+func (e InvalidPart) Error() string {
+	return fmt.Sprintf("Invalid part %d for: %s#%s uploadId: %s", e.PartNumber, e.Bucket, e.Object, e.UploadID)
+}
+
+// MalformedXML - provided XML request body is invalid
+type MalformedXML struct{}
+
+func (e MalformedXML) Error() string {
+	return "The XML you provided was not well-formed or did not validate against our published schema"
+}
+
+// PreconditionFailed - one or more preconditions specified in the request were not met
+type PreconditionFailed GenericObjectError
+
+func (e PreconditionFailed) Error() string {
+	return "At least one of the preconditions you specified did not hold for: " + e.Bucket + "#" + e.Object
+}
+
+// NotModified - object has not been modified since the time specified by the caller
+type NotModified GenericObjectError
+
+func (e NotModified) Error() string {
+	return "Object not modified: " + e.Bucket + "#" + e.Object
+}
+
+// ObjectTampered - object contents do not match their recorded digest
+type ObjectTampered GenericObjectError
+
+func (e ObjectTampered) Error() string {
+	return "Object tampered: " + e.Bucket + "#" + e.Object
+}
+
+// MaximumExpires - requested expires parameter exceeds the maximum allowed
+type MaximumExpires struct {
+	Expires string
+}
+
+func (e MaximumExpires) Error() string {
+	return "Requested expires parameter exceeds maximum allowed: " + e.Expires
+}
+
+// MethodNotAllowed - requested method is not allowed on this resource
+type MethodNotAllowed struct {
+	Resource string
+}
+
+func (e MethodNotAllowed) Error() string {
+	return "Method not allowed on: " + e.Resource
+}
+
+/// Object Lock and Versioning related errors
+
+// NoSuchVersion - requested object version does not exist
+type NoSuchVersion struct {
+	Bucket    string
+	Object    string
+	VersionID string
+}
+
+func (e NoSuchVersion) Error() string {
+	return "No such version: " + e.Bucket + "#" + e.Object + " versionId: " + e.VersionID
+}
+
+// InvalidObjectState - operation is not valid for the current state of the object
+type InvalidObjectState struct {
+	Bucket string
+	Object string
+}
+
+func (e InvalidObjectState) Error() string {
+	return "Invalid object state: " + e.Bucket + "#" + e.Object
+}
+
+// ObjectLocked - object is under a legal hold or retention period and cannot be modified/deleted
+type ObjectLocked struct {
+	Bucket    string
+	Object    string
+	VersionID string
+}
+
+func (e ObjectLocked) Error() string {
+	return "Object locked: " + e.Bucket + "#" + e.Object + " versionId: " + e.VersionID
+}
+
+// InvalidRetentionPeriod - requested retention period is not valid
+type InvalidRetentionPeriod struct {
+	Period string
+}
+
+func (e InvalidRetentionPeriod) Error() string {
+	return "Invalid retention period: " + e.Period
+}
+
+// ObjectLockConfigurationNotFound - bucket has no object lock configuration
+type ObjectLockConfigurationNotFound GenericBucketError
+
+func (e ObjectLockConfigurationNotFound) Error() string {
+	return "Object lock configuration not found for: " + e.Bucket
+}
+
+// NoSuchBucketPolicy - bucket has no policy attached
+type NoSuchBucketPolicy GenericBucketError
+
+func (e NoSuchBucketPolicy) Error() string {
+	return "No bucket policy found for: " + e.Bucket
+}
+
+// NoSuchLifecycleConfiguration - bucket has no lifecycle configuration
+type NoSuchLifecycleConfiguration GenericBucketError
+
+func (e NoSuchLifecycleConfiguration) Error() string {
+	return "No lifecycle configuration found for: " + e.Bucket
+}
+
+// ReplicationConfigurationNotFound - bucket has no replication configuration
+type ReplicationConfigurationNotFound GenericBucketError
+
+func (e ReplicationConfigurationNotFound) Error() string {
+	return "Replication configuration not found for: " + e.Bucket
+}
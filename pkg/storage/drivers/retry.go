@@ -0,0 +1,112 @@
+/*
+ * Minimalist Object Storage, (C) 2015 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drivers
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Retryable - implemented by errors that represent a transient backend
+// condition. Callers use this to distinguish failures worth retrying (after
+// RetryAfter has elapsed) from permanent ones.
+type Retryable interface {
+	error
+	Retryable() bool
+	RetryAfter() time.Duration
+}
+
+// ServiceUnavailable - backend is temporarily unable to serve the request,
+// e.g. too many open file descriptors, or a distributed backend that cannot
+// currently reach quorum.
+type ServiceUnavailable struct {
+	Reason string
+	After  time.Duration
+}
+
+func (e ServiceUnavailable) Error() string {
+	return "Service unavailable: " + e.Reason
+}
+
+// Retryable - ServiceUnavailable always represents a transient condition
+func (e ServiceUnavailable) Retryable() bool {
+	return true
+}
+
+// RetryAfter - minimum duration the caller should wait before retrying
+func (e ServiceUnavailable) RetryAfter() time.Duration {
+	if e.After == 0 {
+		return time.Second
+	}
+	return e.After
+}
+
+// Retryable - SlowDown signals the caller to back off and retry later
+func (e SlowDown) Retryable() bool {
+	return true
+}
+
+// RetryAfter - minimum duration the caller should wait before retrying
+func (e SlowDown) RetryAfter() time.Duration {
+	return time.Second
+}
+
+// Retryable - a corrupted backend path may clear once the underlying disk is repaired
+func (e BackendCorrupted) Retryable() bool {
+	return true
+}
+
+// RetryAfter - minimum duration the caller should wait before retrying
+func (e BackendCorrupted) RetryAfter() time.Duration {
+	return time.Second
+}
+
+// Retryable - failing to reach read quorum may succeed once more disks/nodes come online
+func (e InsufficientReadQuorum) Retryable() bool {
+	return true
+}
+
+// RetryAfter - minimum duration the caller should wait before retrying
+func (e InsufficientReadQuorum) RetryAfter() time.Duration {
+	return time.Second
+}
+
+// Retryable - failing to reach write quorum may succeed once more disks/nodes come online
+func (e InsufficientWriteQuorum) Retryable() bool {
+	return true
+}
+
+// RetryAfter - minimum duration the caller should wait before retrying
+func (e InsufficientWriteQuorum) RetryAfter() time.Duration {
+	return time.Second
+}
+
+// RetryAfterSeconds - returns the number of whole seconds the caller should
+// wait before retrying err, and whether err is retryable at all. Unwraps err
+// (e.g. a DriverError) via errors.As so wrapping with context doesn't hide
+// the underlying Retryable error. The HTTP layer uses this to emit a
+// Retry-After header alongside a 503 response.
+func RetryAfterSeconds(err error) (int, bool) {
+	var retryable Retryable
+	if !errors.As(err, &retryable) || !retryable.Retryable() {
+		return 0, false
+	}
+	// Round up so a sub-second RetryAfter still advertises at least a 1
+	// second wait instead of truncating to 0.
+	return int(math.Ceil(retryable.RetryAfter().Seconds())), true
+}